@@ -0,0 +1,35 @@
+// Package envvar converts between map[string]string and the "KEY=VALUE" slice
+// form used by os.Environ.
+package envvar
+
+import (
+	"strings"
+
+	"github.com/andreas19/maps/v2"
+)
+
+// ToSlice converts m to a sorted slice of "KEY=VALUE" strings.
+// Entries with an empty key are dropped. Values may contain "=".
+func ToSlice(m map[string]string) []string {
+	items := maps.SortedItems(m)
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Key == "" {
+			continue
+		}
+		result = append(result, item.Key+"="+item.Value)
+	}
+	return result
+}
+
+// FromSlice converts a slice of "KEY=VALUE" strings (as returned by os.Environ)
+// to a map. Entries without "=" are treated as having an empty value.
+// For duplicate keys, the last occurrence wins.
+func FromSlice(s []string) map[string]string {
+	items := make([]maps.Item[string, string], 0, len(s))
+	for _, entry := range s {
+		key, value, _ := strings.Cut(entry, "=")
+		items = append(items, maps.Item[string, string]{Key: key, Value: value})
+	}
+	return maps.FromItems(items)
+}
@@ -0,0 +1,45 @@
+package envvar
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToSlice(t *testing.T) {
+	var tests = []struct {
+		m    map[string]string
+		want []string
+	}{
+		{nil, []string{}},
+		{map[string]string{}, []string{}},
+		{map[string]string{"A": "1"}, []string{"A=1"}},
+		{map[string]string{"B": "2", "A": "1"}, []string{"A=1", "B=2"}},
+		{map[string]string{"": "1", "A": "1"}, []string{"A=1"}},
+		{map[string]string{"A": "x=y"}, []string{"A=x=y"}},
+	}
+	for i, test := range tests {
+		if got := ToSlice(test.m); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: got %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	var tests = []struct {
+		s    []string
+		want map[string]string
+	}{
+		{nil, map[string]string{}},
+		{[]string{}, map[string]string{}},
+		{[]string{"A=1"}, map[string]string{"A": "1"}},
+		{[]string{"A=1", "B=2"}, map[string]string{"A": "1", "B": "2"}},
+		{[]string{"A"}, map[string]string{"A": ""}},
+		{[]string{"A=1", "A=2"}, map[string]string{"A": "2"}},
+		{[]string{"A=x=y"}, map[string]string{"A": "x=y"}},
+	}
+	for i, test := range tests {
+		if got := FromSlice(test.s); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: got %v, want %v", i, got, test.want)
+		}
+	}
+}
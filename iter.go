@@ -3,7 +3,7 @@ package maps
 import "iter"
 
 // Iter returns an iterator over key-value pairs from m.
-func Iter[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+func Iter[M ~map[K]V, K comparable, V any](m M) iter.Seq2[K, V] {
 	return func(yield func(K, V) bool) {
 		for k, v := range m {
 			if !yield(k, v) {
@@ -13,7 +13,7 @@ func Iter[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
 	}
 }
 
-func IterKeys[K comparable, V any](m map[K]V) iter.Seq[K] {
+func IterKeys[M ~map[K]V, K comparable, V any](m M) iter.Seq[K] {
 	return func(yield func(K) bool) {
 		for k := range m {
 			if !yield(k) {
@@ -24,7 +24,7 @@ func IterKeys[K comparable, V any](m map[K]V) iter.Seq[K] {
 }
 
 // IterValues returns an iterator over the values from m.
-func IterValues[K comparable, V any](m map[K]V) iter.Seq[V] {
+func IterValues[M ~map[K]V, K comparable, V any](m M) iter.Seq[V] {
 	return func(yield func(V) bool) {
 		for _, v := range m {
 			if !yield(v) {
@@ -42,3 +42,100 @@ func Collect[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
 	}
 	return result
 }
+
+// All returns an iterator over key-value pairs from m. It is the canonical
+// range-over-map iterator, equivalent to [Iter].
+func All[M ~map[K]V, K comparable, V any](m M) iter.Seq2[K, V] {
+	return Iter(m)
+}
+
+// Insert adds the key-value pairs from seq to m.
+// Panics if m is nil.
+func Insert[M ~map[K]V, K comparable, V any](m M, seq iter.Seq2[K, V]) {
+	if m == nil {
+		panic("cannot insert into nil map")
+	}
+	for k, v := range seq {
+		m[k] = v
+	}
+}
+
+// Filter returns an iterator over the key-value pairs from seq for which pred returns true.
+func Filter[K comparable, V any](seq iter.Seq2[K, V], pred func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if pred(k, v) {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// MapKeys returns an iterator which transforms each key from seq using fn.
+func MapKeys[K comparable, V any, K2 comparable](seq iter.Seq2[K, V], fn func(K, V) K2) iter.Seq2[K2, V] {
+	return func(yield func(K2, V) bool) {
+		for k, v := range seq {
+			if !yield(fn(k, v), v) {
+				return
+			}
+		}
+	}
+}
+
+// MapValues returns an iterator which transforms each value from seq using fn.
+func MapValues[K comparable, V any, V2 any](seq iter.Seq2[K, V], fn func(K, V) V2) iter.Seq2[K, V2] {
+	return func(yield func(K, V2) bool) {
+		for k, v := range seq {
+			if !yield(k, fn(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// MapEntries returns an iterator which transforms each key-value pair from seq using fn.
+func MapEntries[K comparable, V any, K2 comparable, V2 any](seq iter.Seq2[K, V], fn func(K, V) (K2, V2)) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			k2, v2 := fn(k, v)
+			if !yield(k2, v2) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns an iterator over non-overlapping chunks of up to n [Item]s from seq.
+// The final chunk may be shorter than n. Panics if n is not positive.
+func Chunk[K comparable, V any](seq iter.Seq2[K, V], n int) iter.Seq[[]Item[K, V]] {
+	if n < 1 {
+		panic("n must be > 0")
+	}
+	return func(yield func([]Item[K, V]) bool) {
+		chunk := make([]Item[K, V], 0, n)
+		for k, v := range seq {
+			chunk = append(chunk, Item[K, V]{k, v})
+			if len(chunk) == n {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]Item[K, V], 0, n)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Reduce reduces seq to a single value by repeatedly applying fn to an accumulator,
+// starting with init.
+func Reduce[K comparable, V any, R any](seq iter.Seq2[K, V], init R, fn func(R, K, V) R) R {
+	acc := init
+	for k, v := range seq {
+		acc = fn(acc, k, v)
+	}
+	return acc
+}
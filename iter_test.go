@@ -0,0 +1,137 @@
+package maps
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"testing"
+)
+
+// seqFromItems returns a deterministic iter.Seq2 which yields items in order,
+// independent of Go's randomized map iteration.
+func seqFromItems[K comparable, V any](items []Item[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, item := range items {
+			if !yield(item.Key, item.Value) {
+				return
+			}
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	got := Collect(All(m))
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("got %v, want %v", got, m)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	m := map[string]int{"a": 1}
+	Insert(m, seqFromItems([]Item[string, int]{{"b", 2}, {"a", 10}}))
+	want := map[string]int{"a": 10, "b": 2}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("got %v, want %v", m, want)
+	}
+}
+
+func TestInsertNil(t *testing.T) {
+	defer func() { _ = recover() }()
+	var m map[string]int
+	Insert(m, seqFromItems([]Item[string, int]{}))
+	t.Errorf("did not panic")
+}
+
+func TestFilter(t *testing.T) {
+	seq := seqFromItems([]Item[string, int]{{"a", 1}, {"b", 2}, {"c", 3}})
+	got := Collect(Filter(seq, func(k string, v int) bool { return v%2 == 1 }))
+	want := map[string]int{"a": 1, "c": 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	seq := seqFromItems([]Item[string, int]{{"a", 1}, {"b", 2}, {"c", 3}})
+	got := Collect(MapKeys(seq, func(k string, v int) int { return len(k) }))
+	// All keys have length 1, so they collide on the mapped key; the last
+	// pair in iteration order wins, as with a plain map assignment.
+	want := map[int]int{1: 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	seq := seqFromItems([]Item[string, int]{{"a", 1}, {"b", 2}})
+	got := Collect(MapValues(seq, func(k string, v int) string { return fmt.Sprintf("%s=%d", k, v) }))
+	want := map[string]string{"a": "a=1", "b": "b=2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestMapEntries(t *testing.T) {
+	seq := seqFromItems([]Item[string, int]{{"a", 1}, {"b", 2}})
+	got := Collect(MapEntries(seq, func(k string, v int) (int, string) { return v, k }))
+	want := map[int]string{1: "a", 2: "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	items := []Item[string, int]{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}}
+
+	var got [][]Item[string, int]
+	for chunk := range Chunk(seqFromItems(items), 2) {
+		got = append(got, chunk)
+	}
+	want := [][]Item[string, int]{
+		{{"a", 1}, {"b", 2}},
+		{{"c", 3}, {"d", 4}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("exact multiple of n: got %v, want %v", got, want)
+	}
+
+	got = nil
+	for chunk := range Chunk(seqFromItems(items), 3) {
+		got = append(got, chunk)
+	}
+	want = [][]Item[string, int]{
+		{{"a", 1}, {"b", 2}, {"c", 3}},
+		{{"d", 4}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("remainder final chunk: got %v, want %v", got, want)
+	}
+}
+
+func TestChunkStopsEarly(t *testing.T) {
+	items := []Item[string, int]{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}}
+	var got [][]Item[string, int]
+	for chunk := range Chunk(seqFromItems(items), 2) {
+		got = append(got, chunk)
+		break
+	}
+	want := [][]Item[string, int]{{{"a", 1}, {"b", 2}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestChunkPanic(t *testing.T) {
+	defer func() { _ = recover() }()
+	Chunk(seqFromItems([]Item[string, int]{}), 0)
+	t.Errorf("did not panic")
+}
+
+func TestReduce(t *testing.T) {
+	seq := seqFromItems([]Item[string, int]{{"a", 1}, {"b", 2}, {"c", 3}})
+	got := Reduce(seq, 0, func(acc int, k string, v int) int { return acc + v })
+	if got != 6 {
+		t.Errorf("got %d, want 6", got)
+	}
+}
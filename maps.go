@@ -1,12 +1,17 @@
 // Package maps provides some functions for working with Go maps.
 package maps
 
+import (
+	"cmp"
+	"sort"
+)
+
 // Clone clones a map.
-func Clone[K comparable, V any](m map[K]V) map[K]V {
+func Clone[M ~map[K]V, K comparable, V any](m M) M {
 	if m == nil {
 		return nil
 	}
-	result := make(map[K]V, len(m))
+	result := make(M, len(m))
 	for k, v := range m {
 		result[k] = v
 	}
@@ -14,7 +19,7 @@ func Clone[K comparable, V any](m map[K]V) map[K]V {
 }
 
 // Update updates a map with items from another map.
-func Update[K comparable, V any](m1, m2 map[K]V) {
+func Update[M ~map[K]V, K comparable, V any](m1, m2 M) {
 	if m1 == nil {
 		panic("cannot update nil map")
 	}
@@ -23,21 +28,50 @@ func Update[K comparable, V any](m1, m2 map[K]V) {
 	}
 }
 
+// Merge returns a new map with the items of m1 and m2. If a key is present
+// in both maps, the value from m2 wins. Panics if m1 is nil.
+func Merge[M ~map[K]V, K comparable, V any](m1, m2 M) M {
+	if m1 == nil {
+		panic("cannot merge nil map")
+	}
+	result := Clone(m1)
+	Update(result, m2)
+	return result
+}
+
+// MergeFunc returns a new map with the items of m1 and m2. If a key is present
+// in both maps, resolve is called with the key and both values to determine
+// the value in the result. Panics if m1 is nil.
+func MergeFunc[M ~map[K]V, K comparable, V any](m1, m2 M, resolve func(k K, v1, v2 V) V) M {
+	if m1 == nil {
+		panic("cannot merge nil map")
+	}
+	result := Clone(m1)
+	for k, v2 := range m2 {
+		if v1, ok := result[k]; ok {
+			result[k] = resolve(k, v1, v2)
+		} else {
+			result[k] = v2
+		}
+	}
+	return result
+}
+
 // Clear removes all items from a map.
-func Clear[K comparable, V any](m map[K]V) {
+func Clear[M ~map[K]V, K comparable, V any](m M) {
 	for k := range m {
 		delete(m, k)
 	}
 }
 
 // Contains returns true if key is in map m.
-func Contains[K comparable, V any](m map[K]V, key K) bool {
+func Contains[M ~map[K]V, K comparable, V any](m M, key K) bool {
 	_, ok := m[key]
 	return ok
 }
 
 // Get returns the value for key from map m or a default value.
-func Get[K comparable, V any](m map[K]V, key K, dflt V) V {
+func Get[M ~map[K]V, K comparable, V any](m M, key K, dflt V) V {
 	v, ok := m[key]
 	if ok {
 		return v
@@ -46,7 +80,7 @@ func Get[K comparable, V any](m map[K]V, key K, dflt V) V {
 }
 
 // Keys returns a slice with all keys from map m.
-func Keys[K comparable, V any](m map[K]V) []K {
+func Keys[M ~map[K]V, K comparable, V any](m M) []K {
 	if m == nil {
 		return nil
 	}
@@ -58,7 +92,7 @@ func Keys[K comparable, V any](m map[K]V) []K {
 }
 
 // Values returns a slice with all values from map m.
-func Values[K comparable, V any](m map[K]V) []V {
+func Values[M ~map[K]V, K comparable, V any](m M) []V {
 	if m == nil {
 		return nil
 	}
@@ -70,14 +104,14 @@ func Values[K comparable, V any](m map[K]V) []V {
 }
 
 // Equal returns true if the two maps are equal (containing the same keys with the same values).
-func Equal[K, V comparable](m1, m2 map[K]V) bool {
+func Equal[M1, M2 ~map[K]V, K, V comparable](m1 M1, m2 M2) bool {
 	return EqualFunc(m1, m2, func(v1, v2 V) bool {
 		return v1 == v2
 	})
 }
 
 // EqualFunc returns true if the two maps are equal using a function to compare values.
-func EqualFunc[K comparable, V any](m1, m2 map[K]V, equal func(v1, v2 V) bool) bool {
+func EqualFunc[M1 ~map[K]V1, M2 ~map[K]V2, K comparable, V1, V2 any](m1 M1, m2 M2, equal func(v1 V1, v2 V2) bool) bool {
 	if len(m1) != len(m2) {
 		return false
 	}
@@ -100,7 +134,7 @@ type Item[K comparable, V any] struct {
 }
 
 // Items returns a slice of [Item] objects for the given map.
-func Items[K comparable, V any](m map[K]V) []Item[K, V] {
+func Items[M ~map[K]V, K comparable, V any](m M) []Item[K, V] {
 	if m == nil {
 		return nil
 	}
@@ -111,6 +145,13 @@ func Items[K comparable, V any](m map[K]V) []Item[K, V] {
 	return result
 }
 
+// SortedItems returns a slice of [Item] objects for the given map, sorted by key.
+func SortedItems[M ~map[K]V, K cmp.Ordered, V any](m M) []Item[K, V] {
+	items := Items(m)
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	return items
+}
+
 // FromItems makes a map from a slice of [Item] objects.
 func FromItems[K comparable, V any](items []Item[K, V]) map[K]V {
 	if items == nil {
@@ -164,7 +205,7 @@ func FromFuncs[K comparable, V any](size int, keys func() K, values func() V) ma
 }
 
 // KeysForValue returns a slice with keys which have the given value.
-func KeysForValue[K, V comparable](m map[K]V, value V) []K {
+func KeysForValue[M ~map[K]V, K, V comparable](m M, value V) []K {
 	return KeysForValueFunc(m, value, func(v1 V, v2 V) bool {
 		return v1 == v2
 	})
@@ -172,7 +213,7 @@ func KeysForValue[K, V comparable](m map[K]V, value V) []K {
 
 // KeysForValueFunc returns a slice with keys which have the given value using
 // a function to compare values.
-func KeysForValueFunc[K comparable, V any](m map[K]V, value V, equal func(v1, v2 V) bool) []K {
+func KeysForValueFunc[M ~map[K]V, K comparable, V any](m M, value V, equal func(v1, v2 V) bool) []K {
 	if m == nil {
 		return nil
 	}
@@ -187,7 +228,7 @@ func KeysForValueFunc[K comparable, V any](m map[K]V, value V, equal func(v1, v2
 
 // Delete deletes all items from m for which fn returns true and
 // returns the number of deleted items.
-func Delete[K comparable, V any](m map[K]V, fn func(k K, v V) bool) int {
+func Delete[M ~map[K]V, K comparable, V any](m M, fn func(k K, v V) bool) int {
 	cnt := 0
 	for k, v := range m {
 		if fn(k, v) {
@@ -197,3 +238,74 @@ func Delete[K comparable, V any](m map[K]V, fn func(k K, v V) bool) int {
 	}
 	return cnt
 }
+
+// GroupBy groups the items from items by the key returned from keyFn, collecting
+// the value returned from valFn for each item under that key.
+func GroupBy[T any, K comparable, V any](items []T, keyFn func(T) K, valFn func(T) V) map[K][]V {
+	result := make(map[K][]V)
+	for _, item := range items {
+		k := keyFn(item)
+		result[k] = append(result[k], valFn(item))
+	}
+	return result
+}
+
+// CountBy counts the items from items by the key returned from keyFn.
+func CountBy[T any, K comparable](items []T, keyFn func(T) K) map[K]int {
+	result := make(map[K]int)
+	for _, item := range items {
+		result[keyFn(item)]++
+	}
+	return result
+}
+
+// Partition splits m into two maps: yes contains the items for which pred
+// returns true, no contains the rest.
+func Partition[M ~map[K]V, K comparable, V any](m M, pred func(k K, v V) bool) (yes, no M) {
+	yes, no = make(M), make(M)
+	for k, v := range m {
+		if pred(k, v) {
+			yes[k] = v
+		} else {
+			no[k] = v
+		}
+	}
+	return yes, no
+}
+
+// Invert returns a new map with the keys and values of m swapped.
+// If two keys have the same value, which one ends up in the result is
+// unspecified, since map iteration order is randomized. Use [InvertFunc]
+// if collisions need to be resolved in a specific way.
+func Invert[M ~map[K]V, K, V comparable](m M) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// InvertFunc returns a new map with the keys and values of m swapped. If two
+// keys map to the same value, resolve is called with the colliding value and
+// the two candidate keys to decide which key wins.
+func InvertFunc[M ~map[K]V, K, V comparable](m M, resolve func(v V, k1, k2 K) K) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		if existing, ok := result[v]; ok {
+			result[v] = resolve(v, existing, k)
+		} else {
+			result[v] = k
+		}
+	}
+	return result
+}
+
+// InvertMulti returns a new map with the keys and values of m swapped,
+// collecting all keys which share the same value.
+func InvertMulti[M ~map[K]V, K, V comparable](m M) map[V][]K {
+	result := make(map[V][]K, len(m))
+	for k, v := range m {
+		result[v] = append(result[v], k)
+	}
+	return result
+}
@@ -354,3 +354,150 @@ func TestDelete(t *testing.T) {
 		}
 	}
 }
+
+// Headers is a named map type used to check that the M ~map[K]V constrained
+// functions round-trip a caller's own map type instead of widening it to map[K]V.
+type Headers map[string]string
+
+func TestNamedMapType(t *testing.T) {
+	h := Headers{"Accept": "text/plain"}
+
+	cloned := Clone(h)
+	if reflect.TypeOf(cloned) != reflect.TypeOf(h) {
+		t.Errorf("Clone: got type %T, want %T", cloned, h)
+	}
+
+	merged := Merge(h, Headers{"Content-Type": "text/html"})
+	if reflect.TypeOf(merged) != reflect.TypeOf(h) {
+		t.Errorf("Merge: got type %T, want %T", merged, h)
+	}
+	want := Headers{"Accept": "text/plain", "Content-Type": "text/html"}
+	if !reflect.DeepEqual(merged, want) {
+		t.Errorf("Merge: got %v, want %v", merged, want)
+	}
+
+	if !Equal(h, Headers{"Accept": "text/plain"}) {
+		t.Errorf("Equal: got false, want true")
+	}
+}
+
+func TestSortedItems(t *testing.T) {
+	var tests = []struct {
+		m    map[string]int
+		want []Item[string, int]
+	}{
+		{nil, nil},
+		{map[string]int{}, []Item[string, int]{}},
+		{map[string]int{"b": 2, "a": 1, "c": 3}, []Item[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}},
+	}
+	for i, test := range tests {
+		if got := SortedItems(test.m); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: got %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestMergeFunc(t *testing.T) {
+	m1 := map[string]int{"a": 1, "b": 2}
+	m2 := map[string]int{"b": 20, "c": 3}
+	got := MergeFunc(m1, m2, func(k string, v1, v2 int) int { return v1 + v2 })
+	want := map[string]int{"a": 1, "b": 22, "c": 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestMergeFuncNil(t *testing.T) {
+	defer func() { _ = recover() }()
+	var m1 map[string]int
+	m2 := map[string]int{}
+	MergeFunc(m1, m2, func(k string, v1, v2 int) int { return v1 })
+	t.Errorf("did not panic")
+}
+
+func TestGroupBy(t *testing.T) {
+	items := []string{"apple", "banana", "avocado", "cherry", "blueberry"}
+	got := GroupBy(items, func(s string) byte { return s[0] }, func(s string) int { return len(s) })
+	want := map[byte][]int{'a': {5, 7}, 'b': {6, 9}, 'c': {6}}
+	for k, v := range want {
+		if !reflect.DeepEqual(got[k], v) {
+			t.Errorf("%c: got %v, want %v", k, got[k], v)
+		}
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	items := []string{"apple", "banana", "avocado", "cherry", "blueberry"}
+	got := CountBy(items, func(s string) byte { return s[0] })
+	want := map[byte]int{'a': 2, 'b': 2, 'c': 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	yes, no := Partition(m, func(k string, v int) bool { return v%2 == 0 })
+	wantYes := map[string]int{"b": 2, "d": 4}
+	wantNo := map[string]int{"a": 1, "c": 3}
+	if !reflect.DeepEqual(yes, wantYes) {
+		t.Errorf("yes: got %v, want %v", yes, wantYes)
+	}
+	if !reflect.DeepEqual(no, wantNo) {
+		t.Errorf("no: got %v, want %v", no, wantNo)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	got := Invert(m)
+	want := map[int]string{1: "a", 2: "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestInvertCollision(t *testing.T) {
+	// "a", "b" and "c" all map to 1; which one wins is unspecified, but the
+	// result must contain exactly one of them plus the uncontested "d".
+	m := map[string]int{"a": 1, "b": 1, "c": 1, "d": 2}
+	got := Invert(m)
+	if len(got) != 2 {
+		t.Fatalf("got %v, want 2 entries", got)
+	}
+	if got[2] != "d" {
+		t.Errorf("got %v, want entry 2: d", got)
+	}
+	switch got[1] {
+	case "a", "b", "c":
+	default:
+		t.Errorf("got %v, want entry 1 to be one of a, b, c", got)
+	}
+}
+
+func TestInvertFunc(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 1, "c": 1, "d": 2}
+	got := InvertFunc(m, func(v int, k1, k2 string) string {
+		if k1 > k2 {
+			return k1
+		}
+		return k2
+	})
+	want := map[int]string{1: "c", 2: "d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestInvertMulti(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 1}
+	got := InvertMulti(m)
+	want := []string{"a", "c"}
+	sort.Strings(got[1])
+	if !reflect.DeepEqual(got[1], want) {
+		t.Errorf("got %v, want %v", got[1], want)
+	}
+	if !reflect.DeepEqual(got[2], []string{"b"}) {
+		t.Errorf("got %v, want %v", got[2], []string{"b"})
+	}
+}
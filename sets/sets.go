@@ -0,0 +1,223 @@
+// Package sets provides a generic set type based on map[K]struct{}.
+package sets
+
+import (
+	"iter"
+
+	"github.com/andreas19/maps/v2"
+)
+
+// Set represents a set of comparable elements.
+type Set[K comparable] map[K]struct{}
+
+// New returns a new [Set] containing the given keys.
+func New[K comparable](keys ...K) Set[K] {
+	s := make(Set[K], len(keys))
+	for _, k := range keys {
+		s[k] = struct{}{}
+	}
+	return s
+}
+
+// FromKeys returns a new [Set] containing the keys from the slice ks.
+func FromKeys[K comparable](ks []K) Set[K] {
+	s := make(Set[K], len(ks))
+	for _, k := range ks {
+		s[k] = struct{}{}
+	}
+	return s
+}
+
+// FromMap returns a new [Set] containing the keys of m.
+func FromMap[K comparable, V any](m map[K]V) Set[K] {
+	return FromKeys(maps.Keys(m))
+}
+
+// FromSlices returns a new [Set] containing the keys from ks. The values
+// slice vs is ignored; it is only present so the signature mirrors
+// [maps.FromSlices], of which this is the set counterpart.
+func FromSlices[K comparable, V any](ks []K, vs []V) Set[K] {
+	return FromKeys(ks)
+}
+
+// ToMap returns a map[K]V with every key of s mapped to value.
+func ToMap[K comparable, V any](s Set[K], value V) map[K]V {
+	m := make(map[K]V, len(s))
+	for k := range s {
+		m[k] = value
+	}
+	return m
+}
+
+// Keys returns a [Set] with the keys from m. It is the set counterpart of [maps.Keys].
+func Keys[K comparable, V any](m map[K]V) Set[K] {
+	return FromMap(m)
+}
+
+// KeysForValue returns a [Set] with the keys from m which have the given value.
+// It is the set counterpart of [maps.KeysForValue].
+func KeysForValue[K, V comparable](m map[K]V, value V) Set[K] {
+	return FromKeys(maps.KeysForValue(m, value))
+}
+
+// Add adds the given keys to s.
+func (s Set[K]) Add(keys ...K) {
+	for _, k := range keys {
+		s[k] = struct{}{}
+	}
+}
+
+// Remove removes the given keys from s. Keys which are not in s are ignored.
+func (s Set[K]) Remove(keys ...K) {
+	for _, k := range keys {
+		delete(s, k)
+	}
+}
+
+// Discard removes key from s and reports whether it was present.
+func (s Set[K]) Discard(key K) bool {
+	_, ok := s[key]
+	delete(s, key)
+	return ok
+}
+
+// Pop removes and returns an arbitrary key from s.
+// The second return value is false if s is empty.
+func (s Set[K]) Pop() (K, bool) {
+	for k := range s {
+		delete(s, k)
+		return k, true
+	}
+	var zero K
+	return zero, false
+}
+
+// Contains returns true if key is in s.
+func (s Set[K]) Contains(key K) bool {
+	_, ok := s[key]
+	return ok
+}
+
+// IsSubset returns true if all keys of s are also in other.
+func (s Set[K]) IsSubset(other Set[K]) bool {
+	for k := range s {
+		if !other.Contains(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if all keys of other are also in s.
+func (s Set[K]) IsSuperset(other Set[K]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint returns true if s and other have no keys in common.
+func (s Set[K]) IsDisjoint(other Set[K]) bool {
+	for k := range s {
+		if other.Contains(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if s and other contain the same keys.
+func (s Set[K]) Equal(other Set[K]) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	return s.IsSubset(other)
+}
+
+// Union returns a new [Set] with the keys from s and other.
+func (s Set[K]) Union(other Set[K]) Set[K] {
+	result := make(Set[K], len(s)+len(other))
+	for k := range s {
+		result[k] = struct{}{}
+	}
+	for k := range other {
+		result[k] = struct{}{}
+	}
+	return result
+}
+
+// UnionUpdate adds the keys from other to s.
+func (s Set[K]) UnionUpdate(other Set[K]) {
+	for k := range other {
+		s[k] = struct{}{}
+	}
+}
+
+// Intersect returns a new [Set] with the keys which are in both s and other.
+func (s Set[K]) Intersect(other Set[K]) Set[K] {
+	result := make(Set[K])
+	for k := range s {
+		if other.Contains(k) {
+			result[k] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IntersectUpdate removes all keys from s which are not in other.
+func (s Set[K]) IntersectUpdate(other Set[K]) {
+	for k := range s {
+		if !other.Contains(k) {
+			delete(s, k)
+		}
+	}
+}
+
+// Difference returns a new [Set] with the keys which are in s but not in other.
+func (s Set[K]) Difference(other Set[K]) Set[K] {
+	result := make(Set[K])
+	for k := range s {
+		if !other.Contains(k) {
+			result[k] = struct{}{}
+		}
+	}
+	return result
+}
+
+// DifferenceUpdate removes all keys from s which are also in other.
+func (s Set[K]) DifferenceUpdate(other Set[K]) {
+	for k := range other {
+		delete(s, k)
+	}
+}
+
+// SymmetricDifference returns a new [Set] with the keys which are in either s or other but not both.
+func (s Set[K]) SymmetricDifference(other Set[K]) Set[K] {
+	result := s.Difference(other)
+	result.UnionUpdate(other.Difference(s))
+	return result
+}
+
+// SymmetricDifferenceUpdate sets s to the keys which are in either s or other but not both.
+func (s Set[K]) SymmetricDifferenceUpdate(other Set[K]) {
+	diff := other.Difference(s)
+	s.DifferenceUpdate(other)
+	s.UnionUpdate(diff)
+}
+
+// Iter returns an iterator over the keys of s.
+func Iter[K comparable](s Set[K]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns a new [Set] with the keys from seq.
+func Collect[K comparable](seq iter.Seq[K]) Set[K] {
+	result := make(Set[K])
+	for k := range seq {
+		result[k] = struct{}{}
+	}
+	return result
+}
@@ -0,0 +1,167 @@
+package sets
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	got := New(1, 2, 2, 3)
+	want := Set[int]{1: {}, 2: {}, 3: {}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFromKeys(t *testing.T) {
+	got := FromKeys([]string{"a", "b", "a"})
+	want := Set[string]{"a": {}, "b": {}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFromSlices(t *testing.T) {
+	got := FromSlices([]string{"a", "b", "a"}, []int{1, 2, 3})
+	want := Set[string]{"a": {}, "b": {}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFromMapAndToMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	s := FromMap(m)
+	want := Set[string]{"a": {}, "b": {}}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+	back := ToMap(s, 0)
+	wantMap := map[string]int{"a": 0, "b": 0}
+	if !reflect.DeepEqual(back, wantMap) {
+		t.Errorf("got %v, want %v", back, wantMap)
+	}
+}
+
+func TestKeysForValue(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 1}
+	got := KeysForValue(m, 1)
+	want := Set[string]{"a": {}, "c": {}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAddRemoveDiscard(t *testing.T) {
+	s := New(1, 2)
+	s.Add(3, 4)
+	if !s.Equal(New(1, 2, 3, 4)) {
+		t.Errorf("Add: got %v", s)
+	}
+	s.Remove(3, 4)
+	if !s.Equal(New(1, 2)) {
+		t.Errorf("Remove: got %v", s)
+	}
+	if ok := s.Discard(1); !ok {
+		t.Errorf("Discard: got false, want true")
+	}
+	if ok := s.Discard(1); ok {
+		t.Errorf("Discard: got true, want false")
+	}
+}
+
+func TestPop(t *testing.T) {
+	s := New(1)
+	k, ok := s.Pop()
+	if !ok || k != 1 {
+		t.Errorf("got %v, %v, want 1, true", k, ok)
+	}
+	if len(s) != 0 {
+		t.Errorf("got len %d, want 0", len(s))
+	}
+	if _, ok := s.Pop(); ok {
+		t.Errorf("got true, want false")
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(1, 2)
+	c := New(4, 5)
+	if !b.IsSubset(a) {
+		t.Errorf("IsSubset: got false, want true")
+	}
+	if !a.IsSuperset(b) {
+		t.Errorf("IsSuperset: got false, want true")
+	}
+	if !a.IsDisjoint(c) {
+		t.Errorf("IsDisjoint: got false, want true")
+	}
+	if a.IsDisjoint(b) {
+		t.Errorf("IsDisjoint: got true, want false")
+	}
+	if !a.Equal(New(3, 2, 1)) {
+		t.Errorf("Equal: got false, want true")
+	}
+}
+
+func TestAlgebra(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+	if got := a.Union(b); !got.Equal(New(1, 2, 3, 4)) {
+		t.Errorf("Union: got %v", got)
+	}
+	if got := a.Intersect(b); !got.Equal(New(2, 3)) {
+		t.Errorf("Intersect: got %v", got)
+	}
+	if got := a.Difference(b); !got.Equal(New(1)) {
+		t.Errorf("Difference: got %v", got)
+	}
+	if got := a.SymmetricDifference(b); !got.Equal(New(1, 4)) {
+		t.Errorf("SymmetricDifference: got %v", got)
+	}
+}
+
+func TestAlgebraUpdate(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+	u := a.Union(New[int]())
+	u.UnionUpdate(b)
+	if !u.Equal(New(1, 2, 3, 4)) {
+		t.Errorf("UnionUpdate: got %v", u)
+	}
+
+	i := a.Union(New[int]())
+	i.IntersectUpdate(b)
+	if !i.Equal(New(2, 3)) {
+		t.Errorf("IntersectUpdate: got %v", i)
+	}
+
+	d := a.Union(New[int]())
+	d.DifferenceUpdate(b)
+	if !d.Equal(New(1)) {
+		t.Errorf("DifferenceUpdate: got %v", d)
+	}
+
+	sd := a.Union(New[int]())
+	sd.SymmetricDifferenceUpdate(b)
+	if !sd.Equal(New(1, 4)) {
+		t.Errorf("SymmetricDifferenceUpdate: got %v", sd)
+	}
+}
+
+func TestIterAndCollect(t *testing.T) {
+	s := New(1, 2, 3)
+	var got []int
+	for k := range Iter(s) {
+		got = append(got, k)
+	}
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+	if back := Collect(Iter(s)); !back.Equal(s) {
+		t.Errorf("Collect: got %v, want %v", back, s)
+	}
+}